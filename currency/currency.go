@@ -0,0 +1,333 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package currency contains ISO 4217 currency metadata: the number of
+// decimal digits and cash-rounding conventions associated with a currency,
+// and the currency or currencies in use in a region at a given time.
+//
+// NOTE: the API for this package has not solidified and may still change.
+package currency // import "golang.org/x/text/currency"
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// ErrSyntax indicates a string does not represent a valid ISO 4217 currency
+// code.
+var ErrSyntax = errors.New("currency: tag is not well-formed")
+
+// A Unit represents an ISO 4217 currency, such as USD (US dollar) or EUR
+// (Euro).
+type Unit struct {
+	iso string // upper-case ISO 4217 code; "" for the zero Unit ("XXX")
+}
+
+// String returns the upper-case ISO 4217 code of u, or "XXX" for the zero
+// Unit.
+func (u Unit) String() string {
+	if u.iso == "" {
+		return "XXX"
+	}
+	return u.iso
+}
+
+// ParseISO parses s, which must be a 3-letter ISO 4217 currency code such
+// as "USD" or "jpy", and returns the matching Unit.
+func ParseISO(s string) (Unit, error) {
+	if len(s) != 3 {
+		return Unit{}, ErrSyntax
+	}
+	iso := strings.ToUpper(s)
+	if !validISOCodes[iso] {
+		return Unit{}, ErrSyntax
+	}
+	return Unit{iso: iso}, nil
+}
+
+// validISOCodes holds every alphabetic ISO 4217 code: the currently
+// assigned codes, the handful of historical ones this package tracks
+// (needed for ValidFrom, ValidTo and ForRegion to report on withdrawn
+// tender), and the non-currency codes (precious metals, XDR, the XTS
+// testing code, and XXX) defined by the standard. This is the set
+// ParseISO recognizes; currencyInfos separately holds only the deviations
+// from the default decimal/rounding convention.
+var validISOCodes = map[string]bool{
+	"AED": true, "AFN": true, "ALL": true, "AMD": true, "ANG": true,
+	"AOA": true, "ARS": true, "AUD": true, "AWG": true, "AZN": true,
+	"BAM": true, "BBD": true, "BDT": true, "BGN": true, "BHD": true,
+	"BIF": true, "BMD": true, "BND": true, "BOB": true, "BOV": true,
+	"BRL": true, "BSD": true, "BTN": true, "BWP": true, "BYN": true,
+	"BZD": true,
+	"CAD": true, "CDF": true, "CHE": true, "CHF": true, "CHW": true,
+	"CLF": true, "CLP": true, "CNY": true, "COP": true, "COU": true,
+	"CRC": true, "CUC": true, "CUP": true, "CVE": true, "CZK": true,
+	"DJF": true, "DKK": true, "DOP": true, "DZD": true,
+	"EGP": true, "ERN": true, "ETB": true, "EUR": true,
+	"FJD": true, "FKP": true,
+	"GBP": true, "GEL": true, "GHS": true, "GIP": true, "GMD": true,
+	"GNF": true, "GTQ": true, "GYD": true,
+	"HKD": true, "HNL": true, "HTG": true, "HUF": true,
+	"IDR": true, "ILS": true, "INR": true, "IQD": true, "IRR": true,
+	"ISK": true,
+	"JMD": true, "JOD": true, "JPY": true,
+	"KES": true, "KGS": true, "KHR": true, "KMF": true, "KPW": true,
+	"KRW": true, "KWD": true, "KYD": true, "KZT": true,
+	"LAK": true, "LBP": true, "LKR": true, "LRD": true, "LSL": true,
+	"LYD": true,
+	"MAD": true, "MDL": true, "MGA": true, "MKD": true, "MMK": true,
+	"MNT": true, "MOP": true, "MRU": true, "MUR": true, "MVR": true,
+	"MWK": true, "MXN": true, "MXV": true, "MYR": true, "MZN": true,
+	"NAD": true, "NGN": true, "NIO": true, "NOK": true, "NPR": true,
+	"NZD": true,
+	"OMR": true,
+	"PAB": true, "PEN": true, "PGK": true, "PHP": true, "PKR": true,
+	"PLN": true, "PYG": true,
+	"QAR": true,
+	"RON": true, "RSD": true, "RUB": true, "RWF": true,
+	"SAR": true, "SBD": true, "SCR": true, "SDG": true, "SEK": true,
+	"SGD": true, "SHP": true, "SLE": true, "SOS": true, "SRD": true,
+	"SSP": true, "STN": true, "SVC": true, "SYP": true, "SZL": true,
+	"THB": true, "TJS": true, "TMT": true, "TND": true, "TOP": true,
+	"TRY": true, "TTD": true, "TWD": true, "TZS": true,
+	"UAH": true, "UGX": true, "USD": true, "USN": true, "UYI": true,
+	"UYU": true, "UYW": true, "UZS": true,
+	"VED": true, "VES": true, "VND": true, "VUV": true,
+	"WST": true,
+	"XAF": true, "XAG": true, "XAU": true, "XBA": true, "XBB": true,
+	"XBC": true, "XBD": true, "XCD": true, "XDR": true, "XOF": true,
+	"XPD": true, "XPF": true, "XPT": true, "XSU": true, "XTS": true,
+	"XUA": true, "XXX": true,
+	"YER": true,
+	"ZAR": true, "ZMW": true, "ZWL": true,
+
+	// Historical codes tracked by regionCurrencies/currencyInfos.
+	"DEM": true, "FRF": true, "ITL": true, "ESP": true,
+}
+
+// currencyInfo records the decimal and cash-rounding conventions for a
+// currency, as defined by ISO 4217 and CLDR's supplementalData.xml
+// (supplementalData/currencyData/fractions). Currencies not listed use the
+// default of 2 decimals and no special cash rounding.
+type currencyInfo struct {
+	decimals     int
+	cashDecimals int
+	cashRounding int // increment, as a multiple of the smallest cash unit
+}
+
+var defaultInfo = currencyInfo{decimals: 2, cashDecimals: 2, cashRounding: 1}
+
+// currencyInfos holds the known deviations from defaultInfo, keyed by ISO
+// 4217 code. Codes not present here (the large majority) use defaultInfo;
+// see validISOCodes for the full set of codes ParseISO recognizes.
+var currencyInfos = map[string]currencyInfo{
+	// Currencies with no minor unit.
+	"BIF": {0, 0, 1},
+	"CLP": {0, 0, 1},
+	"DJF": {0, 0, 1},
+	"GNF": {0, 0, 1},
+	"ISK": {0, 0, 1},
+	"JPY": {0, 0, 1},
+	"KMF": {0, 0, 1},
+	"KRW": {0, 0, 1},
+	"PYG": {0, 0, 1},
+	"RWF": {0, 0, 1},
+	"UGX": {0, 0, 1},
+	"UYI": {0, 0, 1},
+	"VND": {0, 0, 1},
+	"VUV": {0, 0, 1},
+	"XAF": {0, 0, 1},
+	"XOF": {0, 0, 1},
+	"XPF": {0, 0, 1},
+
+	// Currencies with three decimal digits.
+	"BHD": {3, 3, 1},
+	"IQD": {3, 3, 1},
+	"JOD": {3, 3, 1},
+	"KWD": {3, 3, 1},
+	"LYD": {3, 3, 1},
+	"OMR": {3, 3, 1},
+	"TND": {3, 3, 1},
+
+	// Currencies with four decimal digits.
+	"CLF": {4, 4, 1},
+
+	// Currencies with a cash-rounding increment other than the smallest
+	// accounting unit.
+	"CHF": {2, 2, 5}, // rounded to the nearest 0.05 in cash transactions
+	"CAD": {2, 2, 5}, // the cent was withdrawn from circulation in 2013
+
+	// Historical currencies with a non-default convention. DEM and FRF
+	// used the default 2-decimal convention and so need no entry here;
+	// they remain in validISOCodes so ParseISO, ValidTo and ForRegion can
+	// still report on them.
+	"ITL": {0, 0, 1},
+	"ESP": {0, 0, 1},
+}
+
+// info looks up the currencyInfo record backing u, falling back to
+// defaultInfo for the zero Unit.
+func (u Unit) info() currencyInfo {
+	if info, ok := currencyInfos[u.String()]; ok {
+		return info
+	}
+	return defaultInfo
+}
+
+// Decimals reports the number of decimal digits conventionally used with
+// amounts in u (2 for USD, 0 for JPY, 3 for KWD).
+func (u Unit) Decimals() int {
+	return u.info().decimals
+}
+
+// CashDecimals reports the number of decimal digits used when rounding u
+// for cash transactions. It differs from Decimals for currencies, such as
+// CHF, whose smallest circulating coin is larger than their smallest
+// accounting unit.
+func (u Unit) CashDecimals() int {
+	return u.info().cashDecimals
+}
+
+// CashRounding reports the increment, expressed as a multiple of the
+// smallest cash unit of u, to which cash payments are rounded. It is 1 for
+// currencies, such as USD, with no special cash-rounding convention, and 5
+// for currencies like CHF that round cash payments to the nearest 0.05.
+func (u Unit) CashRounding() int {
+	return u.info().cashRounding
+}
+
+// IsTender reports whether u is, to the best of CLDR's knowledge, still in
+// circulation as legal tender anywhere. Only currencies with an entry in
+// regionCurrencies are considered; IsTender reports false for any other
+// currency, such as KWD.
+func (u Unit) IsTender() bool {
+	for _, e := range regionCurrencies {
+		if e.unit == u.String() && e.to.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidFrom reports the earliest date recorded for u becoming valid
+// tender, or the zero Time if this is not recorded or u is unknown.
+func (u Unit) ValidFrom() time.Time {
+	var first time.Time
+	for _, e := range regionCurrencies {
+		if e.unit != u.String() {
+			continue
+		}
+		if first.IsZero() || (!e.from.IsZero() && e.from.Before(first)) {
+			first = e.from
+		}
+	}
+	return first
+}
+
+// ValidTo reports the latest date recorded for u ceasing to be valid
+// tender, or the zero Time if u is still current for some region or its
+// end date is unrecorded.
+func (u Unit) ValidTo() time.Time {
+	var last time.Time
+	for _, e := range regionCurrencies {
+		if e.unit != u.String() {
+			continue
+		}
+		if e.to.IsZero() {
+			return time.Time{}
+		}
+		if e.to.After(last) {
+			last = e.to
+		}
+	}
+	return last
+}
+
+// regionCurrencyEntry records that the currency identified by unit was (or
+// is) valid tender in region from the date from until the date to
+// (exclusive); the zero Time for from or to means unbounded. The table's
+// entries are written by hand from CLDR's supplementalData.xml
+// (supplementalData/currencyData/region).
+//
+// This is a representative subset covering common present-day tender and
+// the euro-changeover countries used as the canonical example in the
+// package docs; it is not exhaustive and should be extended (ideally via a
+// generator reading supplementalData.xml) as more regions are needed.
+type regionCurrencyEntry struct {
+	region string
+	unit   string
+	from   time.Time
+	to     time.Time
+}
+
+func mustDate(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+var regionCurrencies = []regionCurrencyEntry{
+	{region: "US", unit: "USD"},
+	{region: "GB", unit: "GBP"},
+	{region: "JP", unit: "JPY"},
+	{region: "CH", unit: "CHF"},
+	{region: "CN", unit: "CNY"},
+	{region: "IN", unit: "INR"},
+	{region: "BR", unit: "BRL"},
+	{region: "MX", unit: "MXN"},
+	{region: "ZA", unit: "ZAR"},
+	{region: "RU", unit: "RUB"},
+	{region: "SE", unit: "SEK"},
+	{region: "NO", unit: "NOK"},
+	{region: "DK", unit: "DKK"},
+	{region: "PL", unit: "PLN"},
+	{region: "AU", unit: "AUD"},
+	{region: "NZ", unit: "NZD"},
+	{region: "CA", unit: "CAD"},
+
+	// Euro-changeover countries: the legacy currency before the 2002-01-01
+	// cash changeover, and the euro from that date on. (The euro was
+	// irrevocably fixed against these currencies from 1999-01-01, but that
+	// distinction is not tracked here since the legacy notes and coins
+	// remained the tender in circulation until the 2002 changeover.)
+	{region: "DE", unit: "DEM", to: mustDate("2002-01-01")},
+	{region: "DE", unit: "EUR", from: mustDate("2002-01-01")},
+	{region: "FR", unit: "FRF", to: mustDate("2002-01-01")},
+	{region: "FR", unit: "EUR", from: mustDate("2002-01-01")},
+	{region: "IT", unit: "ITL", to: mustDate("2002-01-01")},
+	{region: "IT", unit: "EUR", from: mustDate("2002-01-01")},
+	{region: "ES", unit: "ESP", to: mustDate("2002-01-01")},
+	{region: "ES", unit: "EUR", from: mustDate("2002-01-01")},
+}
+
+// ForRegion reports the currencies that were, or are, legal tender in r at
+// time t, ordered as they appear in the underlying CLDR data. Passing the
+// zero Time reports every currency ever recorded for r. For example,
+// ForRegion for Germany returns DEM for dates before 2002-01-01 and EUR
+// from that date on. Only regions with an entry in regionCurrencies are
+// known; ForRegion returns nil for any other region.
+func ForRegion(r language.Region, t time.Time) []Unit {
+	code := r.String()
+	var units []Unit
+	for _, e := range regionCurrencies {
+		if e.region != code {
+			continue
+		}
+		if !t.IsZero() {
+			if !e.from.IsZero() && t.Before(e.from) {
+				continue
+			}
+			if !e.to.IsZero() && !t.Before(e.to) {
+				continue
+			}
+		}
+		units = append(units, Unit{iso: e.unit})
+	}
+	return units
+}
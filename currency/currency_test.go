@@ -0,0 +1,85 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestParseISO(t *testing.T) {
+	tests := []struct {
+		in      string
+		wantErr bool
+	}{
+		{"USD", false},
+		{"eur", false},
+		{"XXX", true},
+		{"US", true},
+		{"USDD", true},
+	}
+	for _, tt := range tests {
+		u, err := ParseISO(tt.in)
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("ParseISO(%q) error = %v; wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && u.String() == "" {
+			t.Errorf("ParseISO(%q).String() = %q; want non-empty", tt.in, u.String())
+		}
+	}
+}
+
+func TestDecimals(t *testing.T) {
+	tests := []struct {
+		iso          string
+		decimals     int
+		cashDecimals int
+		cashRounding int
+	}{
+		{"USD", 2, 2, 1},
+		{"JPY", 0, 0, 1},
+		{"KWD", 3, 3, 1},
+		{"CHF", 2, 2, 5},
+	}
+	for _, tt := range tests {
+		u, err := ParseISO(tt.iso)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := u.Decimals(); got != tt.decimals {
+			t.Errorf("%s: Decimals() = %d; want %d", tt.iso, got, tt.decimals)
+		}
+		if got := u.CashDecimals(); got != tt.cashDecimals {
+			t.Errorf("%s: CashDecimals() = %d; want %d", tt.iso, got, tt.cashDecimals)
+		}
+		if got := u.CashRounding(); got != tt.cashRounding {
+			t.Errorf("%s: CashRounding() = %d; want %d", tt.iso, got, tt.cashRounding)
+		}
+	}
+}
+
+func TestForRegion(t *testing.T) {
+	de := language.MustParseRegion("DE")
+
+	before := mustDate("2001-06-01")
+	got := ForRegion(de, before)
+	if len(got) != 1 || got[0].String() != "DEM" {
+		t.Errorf("ForRegion(DE, %v) = %v; want [DEM]", before, got)
+	}
+
+	after := mustDate("2010-01-01")
+	got = ForRegion(de, after)
+	if len(got) != 1 || got[0].String() != "EUR" {
+		t.Errorf("ForRegion(DE, %v) = %v; want [EUR]", after, got)
+	}
+
+	all := ForRegion(de, time.Time{})
+	if len(all) != 2 {
+		t.Errorf("ForRegion(DE, zero time) = %v; want 2 entries", all)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package language
+
+// AliasType classifies the kind of alias relationship a deprecated subtag
+// has with its preferred replacement, as recorded in the IANA Language
+// Subtag Registry.
+type AliasType byte
+
+const (
+	// NotDeprecated indicates that a subtag is not an alias for another
+	// subtag.
+	NotDeprecated AliasType = iota
+
+	// Macro indicates that the subtag is an individual language that has
+	// been retired in favor of a macrolanguage that encompasses it.
+	Macro
+
+	// Legacy indicates the subtag has a replacement that is considered the
+	// modern equivalent, but is not related by the macrolanguage mechanism.
+	Legacy
+
+	// Bibliographic applies to subtags that distinguish a bibliographic
+	// convention from the default, terminology-based, convention.
+	Bibliographic
+)
+
+// LanguageInfo reports the deprecation status of a language subtag.
+type LanguageInfo struct {
+	deprecated bool
+	preferred  Base
+	alias      AliasType
+}
+
+// IsDeprecated reports whether the queried subtag is deprecated in favor of
+// another subtag.
+func (i LanguageInfo) IsDeprecated() bool {
+	return i.deprecated
+}
+
+// Preferred returns the subtag that should be used in place of the queried
+// one. It is the zero Base if the subtag is not deprecated.
+func (i LanguageInfo) Preferred() Base {
+	return i.preferred
+}
+
+// Alias reports the kind of alias relationship between the queried subtag
+// and its Preferred replacement. It is NotDeprecated if the subtag is not
+// deprecated.
+func (i LanguageInfo) Alias() AliasType {
+	return i.alias
+}
+
+// Info reports deprecation metadata for the language subtag identified by b.
+func (b Base) Info() LanguageInfo {
+	to, a := normLang(b.langID)
+	if a == langAliasTypeUnknown {
+		return LanguageInfo{}
+	}
+	return LanguageInfo{
+		deprecated: true,
+		preferred:  Base{langID: to},
+		alias:      langAliasTypeToAliasType[a],
+	}
+}
+
+// RegionInfo reports the deprecation status of a region subtag.
+type RegionInfo struct {
+	deprecated bool
+	preferred  Region
+}
+
+// IsDeprecated reports whether the queried region subtag is deprecated in
+// favor of another subtag.
+func (i RegionInfo) IsDeprecated() bool {
+	return i.deprecated
+}
+
+// Preferred returns the region that should be used in place of the queried
+// one. It is the zero Region if the subtag is not deprecated.
+func (i RegionInfo) Preferred() Region {
+	return i.preferred
+}
+
+// Info reports deprecation metadata for the region subtag identified by r.
+func (r Region) Info() RegionInfo {
+	p := normRegion(r.regionID)
+	if p == 0 {
+		return RegionInfo{}
+	}
+	return RegionInfo{deprecated: true, preferred: Region{regionID: p}}
+}
+
+// ScriptInfo reports the deprecation status of a script subtag. The IANA
+// registry currently defines no deprecated script subtags, so IsDeprecated
+// always reports false; the method is provided for symmetry with
+// Base.Info and Region.Info and to allow for future registry changes.
+type ScriptInfo struct {
+	deprecated bool
+	preferred  Script
+}
+
+// IsDeprecated reports whether the queried script subtag is deprecated in
+// favor of another subtag.
+func (i ScriptInfo) IsDeprecated() bool {
+	return i.deprecated
+}
+
+// Preferred returns the script that should be used in place of the queried
+// one. It is the zero Script if the subtag is not deprecated.
+func (i ScriptInfo) Preferred() Script {
+	return i.preferred
+}
+
+// Info reports deprecation metadata for the script subtag identified by s.
+func (s Script) Info() ScriptInfo {
+	return ScriptInfo{}
+}
+
+// Grandfathered reports whether s, the raw BCP 47 tag as written (not a
+// canonicalized Tag), is one of the grandfathered or legacy tags listed in
+// the IANA Language Subtag Registry and, if so, returns its modern
+// replacement. It also recognizes the CLDR-specific tag "root", which maps
+// to "und". A tag with no modern replacement, such as "i-enochian", is
+// returned as a private-use tag ("und-x-i-enochian") with ok set to true.
+// Grandfathered tags such as "i-klingon" or "zh-min-nan"
+// predate BCP 47 and do not decompose into valid subtags, so they must be
+// matched as a whole against the registry before parsing; once parsed and
+// canonicalized into a Tag, the original spelling needed for this match is
+// gone. Callers that need to detect a grandfathered tag must check the raw
+// string first:
+//
+//	if modern, ok := language.Grandfathered(raw); ok {
+//		tag = modern
+//	} else {
+//		tag, err = language.Parse(raw)
+//	}
+func Grandfathered(s string) (modern Tag, ok bool) {
+	return grandfathered(s)
+}
+
+// langAliasTypeToAliasType maps the internal, generated langAliasType
+// values to their exported AliasType equivalents. A map, rather than an
+// indexed array, is used deliberately: langAliasTypeUnknown is a sentinel
+// that is conventionally negative so it can never collide with a valid
+// table index, and a composite literal cannot be indexed by a negative
+// constant.
+var langAliasTypeToAliasType = map[langAliasType]AliasType{
+	langMacro:         Macro,
+	langLegacy:        Legacy,
+	langBibliographic: Bibliographic,
+}
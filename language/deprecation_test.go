@@ -0,0 +1,85 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package language
+
+import "testing"
+
+func TestGrandfathered(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"art-lojban", "jbo", true},
+		{"i-klingon", "tlh", true},
+		{"zh-min-nan", "nan", true},
+		{"zh-hakka", "hak", true},
+		{"root", "und", true},
+		{"en-US", "", false},
+		{"i-enochian", "und-x-i-enochian", true}, // no modern replacement
+	}
+	for _, tt := range tests {
+		got, ok := Grandfathered(tt.in)
+		if ok != tt.ok {
+			t.Errorf("Grandfathered(%q) ok = %v; want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && got.String() != tt.want {
+			t.Errorf("Grandfathered(%q) = %q; want %q", tt.in, got.String(), tt.want)
+		}
+	}
+}
+
+func TestBaseInfo(t *testing.T) {
+	tests := []struct {
+		lang       string
+		deprecated bool
+		preferred  string
+	}{
+		{"id", false, ""},
+		{"in", true, "id"}, // "in" is the deprecated code for Indonesian
+	}
+	for _, tt := range tests {
+		b, err := ParseBase(tt.lang)
+		if err != nil {
+			t.Fatalf("ParseBase(%q): %v", tt.lang, err)
+		}
+		info := b.Info()
+		if got := info.IsDeprecated(); got != tt.deprecated {
+			t.Errorf("Base(%q).Info().IsDeprecated() = %v; want %v", tt.lang, got, tt.deprecated)
+		}
+		if tt.deprecated {
+			if got := info.Preferred().String(); got != tt.preferred {
+				t.Errorf("Base(%q).Info().Preferred() = %q; want %q", tt.lang, got, tt.preferred)
+			}
+		}
+	}
+}
+
+func TestRegionInfo(t *testing.T) {
+	tests := []struct {
+		region     string
+		deprecated bool
+		preferred  string
+	}{
+		{"DE", false, ""},
+		{"ZR", true, "CD"}, // Zaire was renamed to Congo (Kinshasa)
+	}
+	for _, tt := range tests {
+		r, err := ParseRegion(tt.region)
+		if err != nil {
+			t.Fatalf("ParseRegion(%q): %v", tt.region, err)
+		}
+		info := r.Info()
+		if got := info.IsDeprecated(); got != tt.deprecated {
+			t.Errorf("Region(%q).Info().IsDeprecated() = %v; want %v", tt.region, got, tt.deprecated)
+		}
+		if tt.deprecated {
+			if got := info.Preferred().String(); got != tt.preferred {
+				t.Errorf("Region(%q).Info().Preferred() = %q; want %q", tt.region, got, tt.preferred)
+			}
+		}
+	}
+}
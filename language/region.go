@@ -0,0 +1,114 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package language
+
+// regionContainment records, for a UN M.49 or ISO 3166 region code, the
+// codes of the regions directly contained in it, as defined by CLDR's
+// supplementalData.xml (supplementalData/territoryContainment).
+//
+// This is a representative subset of the full CLDR hierarchy: it covers
+// the top-level continents, the European and American subregions down to
+// individual countries, and a flattened (single-level) membership list for
+// the remaining continents. It is not exhaustive and should be extended
+// (ideally via a generator reading supplementalData.xml) as more
+// groupings are needed.
+var regionContainment = map[string][]string{
+	"001": {"002", "019", "142", "150", "009"},
+
+	"019": {"021", "419"},
+	"419": {"013", "029", "005"},
+	"021": {"US", "CA", "BM", "GL"},
+	"013": {"BZ", "CR", "SV", "GT", "HN", "MX", "NI", "PA"},
+	"029": {"CU", "DO", "HT", "JM", "PR", "TT", "AG", "BS", "BB", "DM", "GD", "KN", "LC", "VC"},
+	"005": {"AR", "BO", "BR", "CL", "CO", "EC", "GY", "PY", "PE", "SR", "UY", "VE", "FK", "GF"},
+
+	"150": {"154", "155", "151", "039"},
+	"154": {"DK", "EE", "FI", "IS", "IE", "LV", "LT", "NO", "SE", "GB"},
+	"155": {"DE", "FR", "AT", "BE", "CH", "LI", "LU", "MC", "NL"},
+	"151": {"BY", "BG", "CZ", "HU", "MD", "PL", "RO", "RU", "SK", "UA"},
+	"039": {"AL", "AD", "BA", "HR", "GR", "IT", "MT", "ME", "MK", "PT", "SM", "RS", "SI", "ES", "VA", "XK"},
+
+	"142": {
+		"CN", "JP", "KR", "KP", "MN", "TW", "HK", "MO",
+		"IN", "PK", "BD", "LK", "NP", "BT", "MV",
+		"ID", "MY", "PH", "SG", "TH", "VN", "LA", "KH", "MM", "BN", "TL",
+		"IR", "IQ", "IL", "JO", "LB", "SY", "TR", "SA", "AE", "QA", "KW", "BH", "OM", "YE",
+		"AM", "AZ", "GE", "KZ", "KG", "TJ", "TM", "UZ", "AF", "CY",
+	},
+
+	"002": {
+		"DZ", "EG", "LY", "MA", "TN", "EH", "SD", "SS",
+		"NG", "ZA", "KE", "ET", "GH", "CI", "SN", "TZ", "UG", "ZM", "ZW",
+		"AO", "CM", "CD", "CG", "GA", "RW", "BI", "SO", "DJ", "ER",
+		"LR", "SL", "GN", "GW", "GM", "ML", "BF", "NE", "TD", "MR",
+		"TG", "BJ", "LS", "SZ", "MG", "MW", "CV", "KM", "MU", "SC", "ST", "GQ", "MZ", "BW", "NA",
+	},
+
+	"009": {
+		"AU", "NZ", "PG", "FJ", "SB", "VU", "NC", "PF",
+		"WS", "TO", "KI", "FM", "MH", "PW", "NR", "TV", "CK", "NU", "AS", "GU", "MP",
+	},
+}
+
+// regionParentCode maps a region code to the code of the region directly
+// containing it, derived from regionContainment.
+var regionParentCode = func() map[string]string {
+	m := make(map[string]string)
+	for parent, children := range regionContainment {
+		for _, c := range children {
+			m[c] = parent
+		}
+	}
+	return m
+}()
+
+// Contains reports whether c is r itself or is contained, directly or
+// transitively, in r according to the UN M.49 region groupings used by
+// CLDR. For example, Region "150" (Europe) contains Region "DE".
+func (r Region) Contains(c Region) bool {
+	rCode := r.String()
+	for cur := c; ; {
+		if cur.String() == rCode {
+			return true
+		}
+		p, ok := cur.parent()
+		if !ok {
+			return false
+		}
+		cur = p
+	}
+}
+
+// Parent returns the smallest UN M.49 region grouping that directly
+// contains r, or the zero Region if none is recorded.
+func (r Region) Parent() Region {
+	p, _ := r.parent()
+	return p
+}
+
+func (r Region) parent() (Region, bool) {
+	code, ok := regionParentCode[r.String()]
+	if !ok {
+		return Region{}, false
+	}
+	p, err := ParseRegion(code)
+	if err != nil {
+		return Region{}, false
+	}
+	return p, true
+}
+
+// Children returns the regions directly contained in r according to the UN
+// M.49 region groupings used by CLDR.
+func (r Region) Children() []Region {
+	codes := regionContainment[r.String()]
+	rs := make([]Region, 0, len(codes))
+	for _, code := range codes {
+		if c, err := ParseRegion(code); err == nil {
+			rs = append(rs, c)
+		}
+	}
+	return rs
+}
@@ -0,0 +1,75 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package language
+
+import "testing"
+
+func TestRegionContains(t *testing.T) {
+	tests := []struct {
+		r, c string
+		want bool
+	}{
+		{"150", "DE", true},  // Europe contains Germany
+		{"001", "DE", true},  // World contains Germany, transitively
+		{"419", "MX", true},  // Latin America contains Mexico
+		{"150", "MX", false}, // Europe does not contain Mexico
+		{"DE", "DE", true},   // a region contains itself
+	}
+	for _, tt := range tests {
+		r := mustParseRegion(t, tt.r)
+		c := mustParseRegion(t, tt.c)
+		if got := r.Contains(c); got != tt.want {
+			t.Errorf("Region(%q).Contains(%q) = %v; want %v", tt.r, tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestRegionParent(t *testing.T) {
+	tests := []struct {
+		r, want string
+	}{
+		{"DE", "155"},
+		{"155", "150"},
+		{"150", "001"},
+		{"001", ""},
+	}
+	for _, tt := range tests {
+		r := mustParseRegion(t, tt.r)
+		p := r.Parent()
+		got := ""
+		if p != (Region{}) {
+			got = p.String()
+		}
+		if got != tt.want {
+			t.Errorf("Region(%q).Parent() = %q; want %q", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestRegionChildren(t *testing.T) {
+	r := mustParseRegion(t, "150")
+	children := r.Children()
+	if len(children) == 0 {
+		t.Fatalf("Region(%q).Children() = empty; want non-empty", "150")
+	}
+	found := false
+	for _, c := range children {
+		if c.String() == "155" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Region(%q).Children() = %v; want to include 155", "150", children)
+	}
+}
+
+func mustParseRegion(t *testing.T, s string) Region {
+	t.Helper()
+	r, err := ParseRegion(s)
+	if err != nil {
+		t.Fatalf("ParseRegion(%q): %v", s, err)
+	}
+	return r
+}